@@ -0,0 +1,261 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxDeviceTopology() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetboxDeviceTopologyRead,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):Walks the cable graph starting at a device to expose its L1/L2 neighbors.
+
+Starting from ` + "`device_id`" + `, this data source follows connected cables out to ` + "`max_depth`" + ` hops and returns one entry per interface-to-interface link discovered along the way, including the VLANs visible on each local interface. This makes it possible to assert physical connectivity (e.g. a leaf switch is dual-homed to two spines) from Terraform state without hand-writing a data source per link.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the device to start the topology walk from.",
+			},
+			"max_depth": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "Maximum number of cable hops to traverse away from device_id.",
+			},
+			"links": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Interface-to-interface links discovered during the walk.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"depth": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of cable hops away from device_id this link was discovered at.",
+						},
+						"local_device_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "ID of the device on the local end of the link.",
+						},
+						"local_interface_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "ID of the interface on the local end of the link.",
+						},
+						"local_interface_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the interface on the local end of the link.",
+						},
+						"remote_device_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "ID of the device on the far end of the cable.",
+						},
+						"remote_device_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the device on the far end of the cable.",
+						},
+						"remote_interface_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "ID of the interface on the far end of the cable.",
+						},
+						"remote_interface_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the interface on the far end of the cable.",
+						},
+						"cable_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "ID of the cable connecting the two interfaces.",
+						},
+						"cable_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the connecting cable (e.g. 'connected', 'planned').",
+						},
+						"untagged_vlan_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "ID of the untagged VLAN configured on the local interface, if any.",
+						},
+						"tagged_vlan_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "IDs of the tagged VLANs configured on the local interface.",
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// deviceTopologyWalker carries the per-read caches used to avoid revisiting the
+// same device or cable more than once while traversing the graph. Interfaces
+// are not cached separately: each far-end interface is read once per cable,
+// and every cable is visited at most once.
+type deviceTopologyWalker struct {
+	api             *client.NetBoxAPI
+	visitedDevices  map[int64]bool
+	visitedCables   map[int64]bool
+	links           []map[string]interface{}
+}
+
+func dataSourceNetboxDeviceTopologyRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	deviceID := int64(d.Get("device_id").(int))
+	maxDepth := d.Get("max_depth").(int)
+
+	walker := &deviceTopologyWalker{
+		api:            api,
+		visitedDevices: map[int64]bool{},
+		visitedCables:  map[int64]bool{},
+		links:          []map[string]interface{}{},
+	}
+
+	if err := walker.walk(deviceID, 0, maxDepth); err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(deviceID, 10))
+	d.Set("links", walker.links)
+
+	return nil
+}
+
+func (w *deviceTopologyWalker) walk(deviceID int64, depth, maxDepth int) error {
+	if w.visitedDevices[deviceID] {
+		return nil
+	}
+	w.visitedDevices[deviceID] = true
+
+	if depth >= maxDepth {
+		return nil
+	}
+
+	params := dcim.NewDcimInterfacesListParams().WithDeviceID(&deviceID)
+	params.SetLimit(int64ToPtr(0))
+	res, err := w.api.Dcim.DcimInterfacesList(params, nil)
+	if err != nil {
+		return fmt.Errorf("failed listing interfaces for device %d: %w", deviceID, err)
+	}
+
+	nextDeviceIDs := []int64{}
+
+	for _, iface := range res.GetPayload().Results {
+		if iface.Cable == nil {
+			continue
+		}
+		cableID := iface.Cable.ID
+		if w.visitedCables[cableID] {
+			continue
+		}
+		w.visitedCables[cableID] = true
+
+		cableParams := dcim.NewDcimCablesReadParams().WithID(cableID)
+		cableRes, err := w.api.Dcim.DcimCablesRead(cableParams, nil)
+		if err != nil {
+			return fmt.Errorf("failed reading cable %d: %w", cableID, err)
+		}
+		cable := cableRes.GetPayload()
+
+		remoteInterface, err := w.remoteInterfaceFor(cable, iface)
+		if err != nil {
+			return err
+		}
+		if remoteInterface == nil {
+			continue
+		}
+
+		link := map[string]interface{}{
+			"depth":                 depth,
+			"local_device_id":       deviceID,
+			"local_interface_id":    iface.ID,
+			"local_interface_name":  strValue(iface.Name),
+			"remote_interface_id":   remoteInterface.ID,
+			"remote_interface_name": strValue(remoteInterface.Name),
+			"cable_id":              cableID,
+		}
+		if cable.Status != nil {
+			link["cable_status"] = cable.Status.Value
+		}
+		if remoteInterface.Device != nil {
+			link["remote_device_id"] = remoteInterface.Device.ID
+			link["remote_device_name"] = remoteInterface.Device.Name
+			nextDeviceIDs = append(nextDeviceIDs, remoteInterface.Device.ID)
+		}
+		if iface.UntaggedVlan != nil {
+			link["untagged_vlan_id"] = iface.UntaggedVlan.ID
+		}
+		taggedVlanIDs := make([]int64, 0, len(iface.TaggedVlans))
+		for _, vlan := range iface.TaggedVlans {
+			taggedVlanIDs = append(taggedVlanIDs, vlan.ID)
+		}
+		link["tagged_vlan_ids"] = taggedVlanIDs
+
+		w.links = append(w.links, link)
+	}
+
+	for _, nextDeviceID := range nextDeviceIDs {
+		if err := w.walk(nextDeviceID, depth+1, maxDepth); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// cableTerminationInterfaceType is the content type NetBox uses for cable
+// terminations landing on a device interface, as opposed to a front/rear
+// port, circuit termination or power feed.
+const cableTerminationInterfaceType = "dcim.interface"
+
+// remoteInterfaceFor resolves the interface on the far end of cable relative to
+// the given near-end interface, returning nil if the cable has no far end
+// terminated on an interface (e.g. a planned, single-ended cable, or one
+// terminated on a front/rear port, circuit, or power feed instead).
+func (w *deviceTopologyWalker) remoteInterfaceFor(cable *models.Cable, nearEnd *models.Interface) (*models.Interface, error) {
+	terminations := append(append([]*models.GenericObject{}, cable.ATerminations...), cable.BTerminations...)
+
+	for _, termination := range terminations {
+		if termination == nil || termination.ObjectID == nil || termination.ObjectType == nil {
+			continue
+		}
+		if *termination.ObjectType != cableTerminationInterfaceType {
+			continue
+		}
+		if *termination.ObjectID == nearEnd.ID {
+			continue
+		}
+		params := dcim.NewDcimInterfacesReadParams().WithID(*termination.ObjectID)
+		res, err := w.api.Dcim.DcimInterfacesRead(params, nil)
+		if err != nil {
+			continue
+		}
+		return res.GetPayload(), nil
+	}
+
+	return nil, nil
+}
+
+func strValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
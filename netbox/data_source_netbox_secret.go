@@ -0,0 +1,77 @@
+package netbox
+
+import (
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxSecret() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetboxSecretRead,
+
+		Description: `:meta:subcategory:Secrets:From the [NetBox Secrets plugin documentation](https://github.com/netbox-community/netbox-secrets):
+
+> A secret is used to store confidential information relevant to NetBox objects, such as device access credentials.
+
+Requires the provider to be configured with ` + "`private_key`" + ` or ` + "`private_key_file`" + `.`,
+
+		Schema: map[string]*schema.Schema{
+			"secret_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the secret to look up.",
+			},
+			"role_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "ID of the role this secret is classified under.",
+			},
+			"assigned_object_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Content type of the object this secret is attached to.",
+			},
+			"assigned_object_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "ID of the object this secret is attached to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the secret.",
+			},
+			"plaintext": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Decrypted value of the secret.",
+			},
+		},
+	}
+}
+
+func dataSourceNetboxSecretRead(d *schema.ResourceData, m interface{}) error {
+	secretsAPI, err := getSecretsClient(m.(*client.NetBoxAPI))
+	if err != nil {
+		return err
+	}
+
+	id := int64(d.Get("secret_id").(int))
+
+	var s secret
+	if err := secretsAPI.do("GET", "/api/plugins/secrets/secrets/"+strconv.FormatInt(id, 10)+"/?decrypt=true", nil, &s); err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(s.ID, 10))
+	d.Set("role_id", s.Role)
+	d.Set("assigned_object_type", s.AssignedObjectType)
+	d.Set("assigned_object_id", s.AssignedObjectID)
+	d.Set("name", s.Name)
+	d.Set("plaintext", s.Plaintext)
+
+	return nil
+}
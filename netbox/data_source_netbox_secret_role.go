@@ -0,0 +1,72 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxSecretRole() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetboxSecretRoleRead,
+
+		Description: `:meta:subcategory:Secrets:From the [NetBox Secrets plugin documentation](https://github.com/netbox-community/netbox-secrets):
+
+> Secret roles are used to classify secrets by function.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the secret role.",
+			},
+			"slug": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique slug used in URLs for the secret role.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Brief description of the secret role.",
+			},
+		},
+	}
+}
+
+func dataSourceNetboxSecretRoleRead(d *schema.ResourceData, m interface{}) error {
+	secretsAPI, err := getSecretsClient(m.(*client.NetBoxAPI))
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+
+	var roles struct {
+		Count   int64        `json:"count"`
+		Results []secretRole `json:"results"`
+	}
+	query := url.Values{}
+	query.Set("name", name)
+	if err := secretsAPI.do("GET", "/api/plugins/secrets/secret-roles/?"+query.Encode(), nil, &roles); err != nil {
+		return err
+	}
+
+	if roles.Count > 1 {
+		return errors.New("more than one secret role returned, specify a more narrow filter")
+	}
+	if roles.Count == 0 {
+		return errors.New("no secret role found matching filter")
+	}
+
+	result := roles.Results[0]
+	d.SetId(strconv.FormatInt(result.ID, 10))
+	d.Set("name", result.Name)
+	d.Set("slug", result.Slug)
+	d.Set("description", result.Description)
+
+	return nil
+}
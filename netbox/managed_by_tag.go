@@ -0,0 +1,103 @@
+package netbox
+
+import (
+	"sync"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/extras"
+	"github.com/fbreckle/go-netbox/netbox/models"
+)
+
+// managedByTagByClient holds the managed_by_tag configured for each
+// *client.NetBoxAPI instance. Keying on the api instance, rather than a
+// single package-level value, keeps multiple provider configurations/aliases
+// in the same process from clobbering each other's setting.
+var managedByTagByClient sync.Map
+
+// configureManagedByTag should be called from providerConfigure once api has
+// been built, recording the managed_by_tag option for that client instance.
+// An empty tag disables managed-by tagging for it.
+func configureManagedByTag(api *client.NetBoxAPI, tag string) {
+	if tag == "" {
+		managedByTagByClient.Delete(api)
+		return
+	}
+	managedByTagByClient.Store(api, tag)
+}
+
+func managedByTagFor(api *client.NetBoxAPI) string {
+	tag, _ := managedByTagByClient.Load(api)
+	name, _ := tag.(string)
+	return name
+}
+
+// ensureManagedByTag returns the nested tag for api's configured
+// managed_by_tag, creating it in NetBox first if it doesn't already exist.
+func ensureManagedByTag(api *client.NetBoxAPI) (*models.NestedTag, error) {
+	tagName := managedByTagFor(api)
+
+	listParams := extras.NewExtrasTagsListParams().WithName(&tagName)
+	listRes, err := api.Extras.ExtrasTagsList(listParams, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(listRes.GetPayload().Results) > 0 {
+		tag := listRes.GetPayload().Results[0]
+		return &models.NestedTag{ID: tag.ID, Name: tag.Name, Slug: tag.Slug}, nil
+	}
+
+	slug := getSlug(tagName)
+	createParams := extras.NewExtrasTagsCreateParams().WithData(&models.Tag{
+		Name: &tagName,
+		Slug: &slug,
+	})
+	createRes, err := api.Extras.ExtrasTagsCreate(createParams, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	created := createRes.GetPayload()
+	return &models.NestedTag{ID: created.ID, Name: created.Name, Slug: created.Slug}, nil
+}
+
+// applyManagedByTag merges api's configured managed_by_tag into tags if it is
+// set and not already present. It is a no-op when the option is unset for api.
+func applyManagedByTag(api *client.NetBoxAPI, tags []*models.NestedTag) ([]*models.NestedTag, error) {
+	tagName := managedByTagFor(api)
+	if tagName == "" {
+		return tags, nil
+	}
+
+	for _, tag := range tags {
+		if tag.Name != nil && *tag.Name == tagName {
+			return tags, nil
+		}
+	}
+
+	managedByTag, err := ensureManagedByTag(api)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(tags, managedByTag), nil
+}
+
+// stripManagedByTag filters api's configured managed_by_tag back out of tags
+// read from the API, so it never shows up as drift against the user's
+// configuration. It is a no-op when the option is unset for api.
+func stripManagedByTag(api *client.NetBoxAPI, tags []*models.NestedTag) []*models.NestedTag {
+	tagName := managedByTagFor(api)
+	if tagName == "" {
+		return tags
+	}
+
+	filtered := make([]*models.NestedTag, 0, len(tags))
+	for _, tag := range tags {
+		if tag.Name != nil && *tag.Name == tagName {
+			continue
+		}
+		filtered = append(filtered, tag)
+	}
+	return filtered
+}
@@ -0,0 +1,87 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/models"
+)
+
+func TestManagedByTagFor(t *testing.T) {
+	api := &client.NetBoxAPI{}
+
+	if got := managedByTagFor(api); got != "" {
+		t.Errorf("expected unconfigured client to have no managed_by_tag, got %q", got)
+	}
+
+	configureManagedByTag(api, "terraform-managed")
+	if got := managedByTagFor(api); got != "terraform-managed" {
+		t.Errorf("managedByTagFor() = %q, want %q", got, "terraform-managed")
+	}
+
+	configureManagedByTag(api, "")
+	if got := managedByTagFor(api); got != "" {
+		t.Errorf("expected clearing the tag to remove it, got %q", got)
+	}
+}
+
+func TestManagedByTagForIsPerClient(t *testing.T) {
+	apiA := &client.NetBoxAPI{}
+	apiB := &client.NetBoxAPI{}
+
+	configureManagedByTag(apiA, "terraform-managed")
+	defer configureManagedByTag(apiA, "")
+
+	if got := managedByTagFor(apiB); got != "" {
+		t.Errorf("expected a second client instance not to see the first's managed_by_tag, got %q", got)
+	}
+}
+
+func TestApplyManagedByTagDisabled(t *testing.T) {
+	api := &client.NetBoxAPI{}
+	tags := []*models.NestedTag{{ID: 1, Name: strToPtr("existing")}}
+
+	got, err := applyManagedByTag(api, tags)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 {
+		t.Errorf("expected tags to be unchanged when managed_by_tag is unset, got %v", got)
+	}
+}
+
+func TestApplyManagedByTagAlreadyPresent(t *testing.T) {
+	api := &client.NetBoxAPI{}
+	configureManagedByTag(api, "terraform-managed")
+	defer configureManagedByTag(api, "")
+
+	tags := []*models.NestedTag{{ID: 1, Name: strToPtr("terraform-managed")}}
+	got, err := applyManagedByTag(api, tags)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 {
+		t.Errorf("expected no duplicate tag to be appended, got %v", got)
+	}
+}
+
+func TestStripManagedByTag(t *testing.T) {
+	api := &client.NetBoxAPI{}
+
+	tags := []*models.NestedTag{
+		{ID: 1, Name: strToPtr("keep-me")},
+		{ID: 2, Name: strToPtr("terraform-managed")},
+	}
+
+	if got := stripManagedByTag(api, tags); len(got) != 2 {
+		t.Errorf("expected no-op when managed_by_tag is unset, got %v", got)
+	}
+
+	configureManagedByTag(api, "terraform-managed")
+	defer configureManagedByTag(api, "")
+
+	got := stripManagedByTag(api, tags)
+	if len(got) != 1 || *got[0].Name != "keep-me" {
+		t.Errorf("expected managed_by_tag to be filtered out, got %v", got)
+	}
+}
@@ -0,0 +1,362 @@
+package netbox
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// availableIPAddressParentLocks serializes concurrent allocation requests against
+// the same IP range or prefix, since NetBox's available-ips endpoint is not safe
+// to call in parallel for a single parent: two racing requests can both observe
+// the same free address before either claims it.
+var availableIPAddressParentLocks sync.Map
+
+func availableIPAddressParentLock(key string) *sync.Mutex {
+	lock, _ := availableIPAddressParentLocks.LoadOrStore(key, &sync.Mutex{})
+	return lock.(*sync.Mutex)
+}
+
+var resourceNetboxAvailableIPAddressStatusOptions = []string{"active", "reserved", "deprecated", "dhcp", "slaac"}
+
+func resourceNetboxAvailableIPAddress() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxAvailableIPAddressCreate,
+		Read:   resourceNetboxAvailableIPAddressRead,
+		Update: resourceNetboxAvailableIPAddressUpdate,
+		Delete: resourceNetboxAvailableIPAddressDelete,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):Reserves the next available IP address.
+
+Rather than selecting a specific address, this resource reserves the next available address out of an existing IP range or prefix by calling NetBox's "available IPs" endpoint, so that Terraform configurations don't need to hard-code addresses that NetBox is already tracking availability for.`,
+
+		Schema: map[string]*schema.Schema{
+			"ip_range_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"ip_range_id", "prefix_id"},
+				Description:  "ID of the IP range to allocate the next available address from.",
+			},
+			"prefix_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"ip_range_id", "prefix_id"},
+				Description:  "ID of the prefix to allocate the next available address from.",
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The allocated address, in CIDR notation, as returned by NetBox.",
+			},
+			"dns_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Hostname or FQDN of the device/VM owning this IP address.",
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "active",
+				ValidateFunc: validation.StringInSlice(resourceNetboxAvailableIPAddressStatusOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxAvailableIPAddressStatusOptions),
+			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the tenant this IP address belongs to.",
+			},
+			"vrf_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the VRF this IP address belongs to.",
+			},
+			"role_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the functional role of this IP address.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Brief description of the IP address.",
+			},
+			"assigned_object_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"assigned_object_id"},
+				Description:  "The content type of the object (e.g. 'dcim.interface') this IP address should be assigned to.",
+			},
+			"assigned_object_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"assigned_object_type"},
+				Description:  "The ID of the object this IP address should be assigned to.",
+			},
+			tagsKey: tagsSchema,
+		},
+	}
+}
+
+// availableIPAddressAllocationRetries bounds how many times in total Create
+// attempts the available-ips POST, retrying while NetBox reports the pool was
+// exhausted or another writer claimed the slot before us.
+const availableIPAddressAllocationRetries = 5
+
+func resourceNetboxAvailableIPAddressCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	ipRangeIDValue, useIPRange := d.GetOk("ip_range_id")
+	prefixIDValue, usePrefix := d.GetOk("prefix_id")
+
+	if !useIPRange && !usePrefix {
+		return fmt.Errorf("one of ip_range_id or prefix_id must be set")
+	}
+
+	data := &models.WritableAvailableIP{}
+	data.Description = d.Get("description").(string)
+	data.DNSName = d.Get("dns_name").(string)
+	data.Status = d.Get("status").(string)
+
+	if vrfID, ok := d.GetOk("vrf_id"); ok {
+		data.Vrf = int64ToPtr(int64(vrfID.(int)))
+	}
+	if tenantID, ok := d.GetOk("tenant_id"); ok {
+		data.Tenant = int64ToPtr(int64(tenantID.(int)))
+	}
+	if roleID, ok := d.GetOk("role_id"); ok {
+		data.Role = int64ToPtr(int64(roleID.(int)))
+	}
+	if objType, ok := d.GetOk("assigned_object_type"); ok {
+		data.AssignedObjectType = strToPtr(objType.(string))
+	}
+	if objID, ok := d.GetOk("assigned_object_id"); ok {
+		data.AssignedObjectID = int64ToPtr(int64(objID.(int)))
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	var lockKey string
+	if useIPRange {
+		lockKey = fmt.Sprintf("ip_range/%d", ipRangeIDValue.(int))
+	} else {
+		lockKey = fmt.Sprintf("prefix/%d", prefixIDValue.(int))
+	}
+
+	lock := availableIPAddressParentLock(lockKey)
+	lock.Lock()
+	defer lock.Unlock()
+
+	var id int64
+	var backoff = 500 * time.Millisecond
+	var lastErr error
+	for attempt := 0; attempt < availableIPAddressAllocationRetries; attempt++ {
+		var err error
+		if useIPRange {
+			id, err = createAvailableIPFromIPRange(api, int64(ipRangeIDValue.(int)), data)
+		} else {
+			id, err = createAvailableIPFromPrefix(api, int64(prefixIDValue.(int)), data)
+		}
+		if err == nil {
+			d.SetId(strconv.FormatInt(id, 10))
+			return resourceNetboxAvailableIPAddressRead(d, m)
+		}
+
+		if !isAvailableIPExhaustedError(err) {
+			return err
+		}
+
+		lastErr = err
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+
+	return fmt.Errorf("could not allocate an available IP address after %d attempts: %w", availableIPAddressAllocationRetries, lastErr)
+}
+
+func createAvailableIPFromIPRange(api *client.NetBoxAPI, ipRangeID int64, data *models.WritableAvailableIP) (int64, error) {
+	params := ipam.NewIpamIPRangesAvailableIpsCreateParams().WithID(ipRangeID).WithData([]*models.WritableAvailableIP{data})
+	res, err := api.Ipam.IpamIPRangesAvailableIpsCreate(params, nil)
+	if err != nil {
+		return 0, err
+	}
+	results := res.GetPayload()
+	if len(results) == 0 {
+		return 0, fmt.Errorf("ip range %d has no available addresses", ipRangeID)
+	}
+	return results[0].ID, nil
+}
+
+func createAvailableIPFromPrefix(api *client.NetBoxAPI, prefixID int64, data *models.WritableAvailableIP) (int64, error) {
+	params := ipam.NewIpamPrefixesAvailableIpsCreateParams().WithID(prefixID).WithData([]*models.WritableAvailableIP{data})
+	res, err := api.Ipam.IpamPrefixesAvailableIpsCreate(params, nil)
+	if err != nil {
+		return 0, err
+	}
+	results := res.GetPayload()
+	if len(results) == 0 {
+		return 0, fmt.Errorf("prefix %d has no available addresses", prefixID)
+	}
+	return results[0].ID, nil
+}
+
+// availableIPExhaustedMessages are the substrings NetBox's available-ips
+// endpoint is known to use when a 400 means the pool was exhausted or a
+// racing writer claimed the address first, as opposed to a validation error
+// on one of the fields we submitted (bad status, unknown VRF, etc.), which
+// must not be retried and should surface to the user immediately.
+var availableIPExhaustedMessages = []string{
+	"no more available",
+	"already been assigned",
+	"already has an ip",
+}
+
+// isAvailableIPExhaustedError reports whether err looks like NetBox telling us the
+// pool was exhausted or that a racing writer claimed the slot we were about to
+// take, both of which are worth retrying rather than failing Create outright.
+// Any other 4xx, including ordinary 400 validation errors, is returned as-is.
+func isAvailableIPExhaustedError(err error) bool {
+	switch e := err.(type) {
+	case *ipam.IpamIPRangesAvailableIpsCreateDefault:
+		return isAvailableIPExhaustedResponse(e.Code(), fmt.Sprintf("%v", e.Payload))
+	case *ipam.IpamPrefixesAvailableIpsCreateDefault:
+		return isAvailableIPExhaustedResponse(e.Code(), fmt.Sprintf("%v", e.Payload))
+	default:
+		return false
+	}
+}
+
+// isAvailableIPExhaustedResponse is the pure decision behind
+// isAvailableIPExhaustedError: a 409 is always a conflicting writer, a 400 is
+// only treated as exhaustion if its detail mentions one of the known
+// exhaustion/race signals, and anything else (e.g. a plain validation error)
+// is not retried.
+func isAvailableIPExhaustedResponse(code int, detail string) bool {
+	if code == http.StatusConflict {
+		return true
+	}
+	if code != http.StatusBadRequest {
+		return false
+	}
+
+	lowerDetail := strings.ToLower(detail)
+	for _, signal := range availableIPExhaustedMessages {
+		if strings.Contains(lowerDetail, signal) {
+			return true
+		}
+	}
+	return false
+}
+
+func resourceNetboxAvailableIPAddressRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := ipam.NewIpamIPAddressesReadParams().WithID(id)
+
+	res, err := api.Ipam.IpamIPAddressesRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*ipam.IpamIPAddressesReadDefault); ok {
+			if errresp.Code() == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	ipAddress := res.GetPayload()
+	d.Set("address", ipAddress.Address)
+	d.Set("dns_name", ipAddress.DNSName)
+	if ipAddress.Status != nil {
+		d.Set("status", ipAddress.Status.Value)
+	}
+	d.Set("description", ipAddress.Description)
+
+	if ipAddress.Tenant != nil {
+		d.Set("tenant_id", ipAddress.Tenant.ID)
+	}
+	if ipAddress.Vrf != nil {
+		d.Set("vrf_id", ipAddress.Vrf.ID)
+	}
+	if ipAddress.Role != nil {
+		d.Set("role_id", ipAddress.Role.ID)
+	}
+	if ipAddress.AssignedObjectType != nil {
+		d.Set("assigned_object_type", ipAddress.AssignedObjectType)
+	}
+	if ipAddress.AssignedObjectID != nil {
+		d.Set("assigned_object_id", ipAddress.AssignedObjectID)
+	}
+
+	d.Set(tagsKey, getTagListFromNestedTagList(ipAddress.Tags))
+
+	return nil
+}
+
+func resourceNetboxAvailableIPAddressUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	data := models.WritableIPAddress{}
+
+	data.Address = strToPtr(d.Get("address").(string))
+	data.DNSName = d.Get("dns_name").(string)
+	data.Status = d.Get("status").(string)
+	data.Description = d.Get("description").(string)
+
+	if vrfID, ok := d.GetOk("vrf_id"); ok {
+		data.Vrf = int64ToPtr(int64(vrfID.(int)))
+	}
+	if tenantID, ok := d.GetOk("tenant_id"); ok {
+		data.Tenant = int64ToPtr(int64(tenantID.(int)))
+	}
+	if roleID, ok := d.GetOk("role_id"); ok {
+		data.Role = int64ToPtr(int64(roleID.(int)))
+	}
+	if objType, ok := d.GetOk("assigned_object_type"); ok {
+		data.AssignedObjectType = strToPtr(objType.(string))
+	}
+	if objID, ok := d.GetOk("assigned_object_id"); ok {
+		data.AssignedObjectID = int64ToPtr(int64(objID.(int)))
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	params := ipam.NewIpamIPAddressesUpdateParams().WithID(id).WithData(&data)
+	_, err := api.Ipam.IpamIPAddressesUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxAvailableIPAddressRead(d, m)
+}
+
+func resourceNetboxAvailableIPAddressDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := ipam.NewIpamIPAddressesDeleteParams().WithID(id)
+
+	_, err := api.Ipam.IpamIPAddressesDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*ipam.IpamIPAddressesDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	return nil
+}
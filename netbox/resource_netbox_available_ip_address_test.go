@@ -0,0 +1,29 @@
+package netbox
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestIsAvailableIPExhaustedResponse(t *testing.T) {
+	cases := []struct {
+		name   string
+		code   int
+		detail string
+		want   bool
+	}{
+		{"conflict is always retried", http.StatusConflict, "", true},
+		{"exhausted pool", http.StatusBadRequest, "No more available IPs found in this range", true},
+		{"already assigned", http.StatusBadRequest, "This address has already been assigned", true},
+		{"already has an ip", http.StatusBadRequest, "Device already has an IP on this interface", true},
+		{"ordinary validation error", http.StatusBadRequest, "Status is not a valid choice", false},
+		{"unrelated 4xx", http.StatusForbidden, "no more available", false},
+		{"unrelated 500", http.StatusInternalServerError, "no more available", false},
+	}
+
+	for _, c := range cases {
+		if got := isAvailableIPExhaustedResponse(c.code, c.detail); got != c.want {
+			t.Errorf("%s: isAvailableIPExhaustedResponse(%d, %q) = %v, want %v", c.name, c.code, c.detail, got, c.want)
+		}
+	}
+}
@@ -97,6 +97,11 @@ func resourceNetboxDeviceTypeCreate(d *schema.ResourceData, m interface{}) error
 	}
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	managedTags, err := applyManagedByTag(api, data.Tags)
+	if err != nil {
+		return err
+	}
+	data.Tags = managedTags
 
 	params := dcim.NewDcimDeviceTypesCreateParams().WithData(&data)
 
@@ -136,7 +141,7 @@ func resourceNetboxDeviceTypeRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("part_number", deviceType.PartNumber)
 	d.Set("u_height", deviceType.UHeight)
 	d.Set("is_full_depth", deviceType.IsFullDepth)
-	d.Set(tagsKey, getTagListFromNestedTagList(deviceType.Tags))
+	d.Set(tagsKey, getTagListFromNestedTagList(stripManagedByTag(api, deviceType.Tags)))
 
 	return nil
 }
@@ -174,10 +179,15 @@ func resourceNetboxDeviceTypeUpdate(d *schema.ResourceData, m interface{}) error
 	}
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	managedTags, err := applyManagedByTag(api, data.Tags)
+	if err != nil {
+		return err
+	}
+	data.Tags = managedTags
 
 	params := dcim.NewDcimDeviceTypesPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Dcim.DcimDeviceTypesPartialUpdate(params, nil)
+	_, err = api.Dcim.DcimDeviceTypesPartialUpdate(params, nil)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,167 @@
+package netbox
+
+import (
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxDeviceTypeConsolePortTemplateTypeOptions = []string{"de-9", "db-25", "rj-11", "rj-12", "rj-45", "usb-a", "usb-b", "usb-c", "usb-mini-a", "usb-mini-b", "usb-micro-a", "usb-micro-b", "usb-micro-ab", "other"}
+
+func resourceNetboxDeviceTypeConsolePortTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDeviceTypeConsolePortTemplateCreate,
+		Read:   resourceNetboxDeviceTypeConsolePortTemplateRead,
+		Update: resourceNetboxDeviceTypeConsolePortTemplateUpdate,
+		Delete: resourceNetboxDeviceTypeConsolePortTemplateDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/features/device-types/#component-templates):
+
+> Component templates effectively serve as a pattern for the automatic creation of components when a new device is added.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_type_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the device type this console port template belongs to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the console port template (e.g. 'Console{n}').",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxDeviceTypeConsolePortTemplateTypeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxDeviceTypeConsolePortTemplateTypeOptions),
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Physical label of the console port template.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Brief description of the console port template.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxDeviceTypeConsolePortTemplateCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	data := models.WritableConsolePortTemplate{}
+
+	deviceTypeID := int64(d.Get("device_type_id").(int))
+	data.DeviceType = &deviceTypeID
+
+	name := d.Get("name").(string)
+	data.Name = &name
+
+	if portType, ok := d.GetOk("type"); ok {
+		data.Type = portType.(string)
+	}
+
+	data.Label = d.Get("label").(string)
+	data.Description = d.Get("description").(string)
+
+	params := dcim.NewDcimConsolePortTemplatesCreateParams().WithData(&data)
+
+	res, err := api.Dcim.DcimConsolePortTemplatesCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxDeviceTypeConsolePortTemplateRead(d, m)
+}
+
+func resourceNetboxDeviceTypeConsolePortTemplateRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimConsolePortTemplatesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimConsolePortTemplatesRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimConsolePortTemplatesReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	consolePortTemplate := res.GetPayload()
+	d.Set("device_type_id", consolePortTemplate.DeviceType.ID)
+	d.Set("name", consolePortTemplate.Name)
+	if consolePortTemplate.Type != nil {
+		d.Set("type", consolePortTemplate.Type.Value)
+	}
+	d.Set("label", consolePortTemplate.Label)
+	d.Set("description", consolePortTemplate.Description)
+
+	return nil
+}
+
+func resourceNetboxDeviceTypeConsolePortTemplateUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	data := models.WritableConsolePortTemplate{}
+
+	deviceTypeID := int64(d.Get("device_type_id").(int))
+	data.DeviceType = &deviceTypeID
+
+	name := d.Get("name").(string)
+	data.Name = &name
+
+	if portType, ok := d.GetOk("type"); ok {
+		data.Type = portType.(string)
+	}
+
+	data.Label = d.Get("label").(string)
+	data.Description = d.Get("description").(string)
+
+	params := dcim.NewDcimConsolePortTemplatesPartialUpdateParams().WithID(id).WithData(&data)
+
+	_, err := api.Dcim.DcimConsolePortTemplatesPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDeviceTypeConsolePortTemplateRead(d, m)
+}
+
+func resourceNetboxDeviceTypeConsolePortTemplateDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimConsolePortTemplatesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimConsolePortTemplatesDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimConsolePortTemplatesDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package netbox
+
+import (
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNetboxDeviceTypeDeviceBayTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDeviceTypeDeviceBayTemplateCreate,
+		Read:   resourceNetboxDeviceTypeDeviceBayTemplateRead,
+		Update: resourceNetboxDeviceTypeDeviceBayTemplateUpdate,
+		Delete: resourceNetboxDeviceTypeDeviceBayTemplateDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/features/device-types/#component-templates):
+
+> Component templates effectively serve as a pattern for the automatic creation of components when a new device is added.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_type_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the device type this device bay template belongs to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the device bay template (e.g. 'Bay{n}').",
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Physical label of the device bay template.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Brief description of the device bay template.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxDeviceTypeDeviceBayTemplateCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	data := models.WritableDeviceBayTemplate{}
+
+	deviceTypeID := int64(d.Get("device_type_id").(int))
+	data.DeviceType = &deviceTypeID
+
+	name := d.Get("name").(string)
+	data.Name = &name
+
+	data.Label = d.Get("label").(string)
+	data.Description = d.Get("description").(string)
+
+	params := dcim.NewDcimDeviceBayTemplatesCreateParams().WithData(&data)
+
+	res, err := api.Dcim.DcimDeviceBayTemplatesCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxDeviceTypeDeviceBayTemplateRead(d, m)
+}
+
+func resourceNetboxDeviceTypeDeviceBayTemplateRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimDeviceBayTemplatesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimDeviceBayTemplatesRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimDeviceBayTemplatesReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	deviceBayTemplate := res.GetPayload()
+	d.Set("device_type_id", deviceBayTemplate.DeviceType.ID)
+	d.Set("name", deviceBayTemplate.Name)
+	d.Set("label", deviceBayTemplate.Label)
+	d.Set("description", deviceBayTemplate.Description)
+
+	return nil
+}
+
+func resourceNetboxDeviceTypeDeviceBayTemplateUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	data := models.WritableDeviceBayTemplate{}
+
+	deviceTypeID := int64(d.Get("device_type_id").(int))
+	data.DeviceType = &deviceTypeID
+
+	name := d.Get("name").(string)
+	data.Name = &name
+
+	data.Label = d.Get("label").(string)
+	data.Description = d.Get("description").(string)
+
+	params := dcim.NewDcimDeviceBayTemplatesPartialUpdateParams().WithID(id).WithData(&data)
+
+	_, err := api.Dcim.DcimDeviceBayTemplatesPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDeviceTypeDeviceBayTemplateRead(d, m)
+}
+
+func resourceNetboxDeviceTypeDeviceBayTemplateDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimDeviceBayTemplatesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimDeviceBayTemplatesDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimDeviceBayTemplatesDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package netbox
+
+import (
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxDeviceTypeFrontPortTemplateTypeOptions = []string{"8p8c", "110-punch", "bnc", "mrj21", "fc", "lc", "sc", "st", "other"}
+
+func resourceNetboxDeviceTypeFrontPortTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDeviceTypeFrontPortTemplateCreate,
+		Read:   resourceNetboxDeviceTypeFrontPortTemplateRead,
+		Update: resourceNetboxDeviceTypeFrontPortTemplateUpdate,
+		Delete: resourceNetboxDeviceTypeFrontPortTemplateDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/features/device-types/#component-templates):
+
+> Component templates effectively serve as a pattern for the automatic creation of components when a new device is added. Front port templates are mapped to a corresponding rear port template, optionally by position, to model patch panels and similar pass-through hardware.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_type_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the device type this front port template belongs to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the front port template (e.g. 'Front{n}').",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxDeviceTypeFrontPortTemplateTypeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxDeviceTypeFrontPortTemplateTypeOptions),
+			},
+			"rear_port_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the rear port template this front port template is mapped to.",
+			},
+			"rear_port_position": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "Position on the rear port template this front port template is mapped to.",
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Physical label of the front port template.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Brief description of the front port template.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxDeviceTypeFrontPortTemplateCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	data := models.WritableFrontPortTemplate{}
+
+	deviceTypeID := int64(d.Get("device_type_id").(int))
+	data.DeviceType = &deviceTypeID
+
+	name := d.Get("name").(string)
+	data.Name = &name
+
+	portType := d.Get("type").(string)
+	data.Type = &portType
+
+	rearPortID := int64(d.Get("rear_port_id").(int))
+	data.RearPort = &rearPortID
+
+	data.RearPortPosition = int64(d.Get("rear_port_position").(int))
+	data.Label = d.Get("label").(string)
+	data.Description = d.Get("description").(string)
+
+	params := dcim.NewDcimFrontPortTemplatesCreateParams().WithData(&data)
+
+	res, err := api.Dcim.DcimFrontPortTemplatesCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxDeviceTypeFrontPortTemplateRead(d, m)
+}
+
+func resourceNetboxDeviceTypeFrontPortTemplateRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimFrontPortTemplatesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimFrontPortTemplatesRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimFrontPortTemplatesReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	frontPortTemplate := res.GetPayload()
+	d.Set("device_type_id", frontPortTemplate.DeviceType.ID)
+	d.Set("name", frontPortTemplate.Name)
+	if frontPortTemplate.Type != nil {
+		d.Set("type", frontPortTemplate.Type.Value)
+	}
+	d.Set("rear_port_id", frontPortTemplate.RearPort.ID)
+	d.Set("rear_port_position", frontPortTemplate.RearPortPosition)
+	d.Set("label", frontPortTemplate.Label)
+	d.Set("description", frontPortTemplate.Description)
+
+	return nil
+}
+
+func resourceNetboxDeviceTypeFrontPortTemplateUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	data := models.WritableFrontPortTemplate{}
+
+	deviceTypeID := int64(d.Get("device_type_id").(int))
+	data.DeviceType = &deviceTypeID
+
+	name := d.Get("name").(string)
+	data.Name = &name
+
+	portType := d.Get("type").(string)
+	data.Type = &portType
+
+	rearPortID := int64(d.Get("rear_port_id").(int))
+	data.RearPort = &rearPortID
+
+	data.RearPortPosition = int64(d.Get("rear_port_position").(int))
+	data.Label = d.Get("label").(string)
+	data.Description = d.Get("description").(string)
+
+	params := dcim.NewDcimFrontPortTemplatesPartialUpdateParams().WithID(id).WithData(&data)
+
+	_, err := api.Dcim.DcimFrontPortTemplatesPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDeviceTypeFrontPortTemplateRead(d, m)
+}
+
+func resourceNetboxDeviceTypeFrontPortTemplateDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimFrontPortTemplatesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimFrontPortTemplatesDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimFrontPortTemplatesDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package netbox
+
+import (
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxDeviceTypeInterfaceTemplateTypeOptions = []string{"virtual", "lag", "1000base-t", "2.5gbase-t", "5gbase-t", "10gbase-t", "10gbase-cx4", "1000base-x-sfp", "10gbase-x-sfpp", "25gbase-x-sfp28", "40gbase-x-qsfpp", "100gbase-x-qsfp28", "other"}
+
+func resourceNetboxDeviceTypeInterfaceTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDeviceTypeInterfaceTemplateCreate,
+		Read:   resourceNetboxDeviceTypeInterfaceTemplateRead,
+		Update: resourceNetboxDeviceTypeInterfaceTemplateUpdate,
+		Delete: resourceNetboxDeviceTypeInterfaceTemplateDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/features/device-types/#component-templates):
+
+> Component templates effectively serve as a pattern for the automatic creation of components when a new device is added. For example, a device type specifies a particular model of switch with 48 ports, and an interface template can be created for each of these 48 ports so that every time a device is created with this device type, the interfaces are automatically created along with it, saving time.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_type_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the device type this interface template belongs to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the interface template (e.g. 'GigabitEthernet{module}/{n}').",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxDeviceTypeInterfaceTemplateTypeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxDeviceTypeInterfaceTemplateTypeOptions),
+			},
+			"mgmt_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Indicates if the interface template is for management purposes only.",
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Physical label of the interface template.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Brief description of the interface template.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxDeviceTypeInterfaceTemplateCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	data := models.WritableInterfaceTemplate{}
+
+	deviceTypeID := int64(d.Get("device_type_id").(int))
+	data.DeviceType = &deviceTypeID
+
+	name := d.Get("name").(string)
+	data.Name = &name
+
+	ifaceType := d.Get("type").(string)
+	data.Type = &ifaceType
+
+	data.MgmtOnly = d.Get("mgmt_only").(bool)
+	data.Label = d.Get("label").(string)
+	data.Description = d.Get("description").(string)
+
+	params := dcim.NewDcimInterfaceTemplatesCreateParams().WithData(&data)
+
+	res, err := api.Dcim.DcimInterfaceTemplatesCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxDeviceTypeInterfaceTemplateRead(d, m)
+}
+
+func resourceNetboxDeviceTypeInterfaceTemplateRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimInterfaceTemplatesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimInterfaceTemplatesRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimInterfaceTemplatesReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	ifaceTemplate := res.GetPayload()
+	d.Set("device_type_id", ifaceTemplate.DeviceType.ID)
+	d.Set("name", ifaceTemplate.Name)
+	if ifaceTemplate.Type != nil {
+		d.Set("type", ifaceTemplate.Type.Value)
+	}
+	d.Set("mgmt_only", ifaceTemplate.MgmtOnly)
+	d.Set("label", ifaceTemplate.Label)
+	d.Set("description", ifaceTemplate.Description)
+
+	return nil
+}
+
+func resourceNetboxDeviceTypeInterfaceTemplateUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	data := models.WritableInterfaceTemplate{}
+
+	deviceTypeID := int64(d.Get("device_type_id").(int))
+	data.DeviceType = &deviceTypeID
+
+	name := d.Get("name").(string)
+	data.Name = &name
+
+	ifaceType := d.Get("type").(string)
+	data.Type = &ifaceType
+
+	data.MgmtOnly = d.Get("mgmt_only").(bool)
+	data.Label = d.Get("label").(string)
+	data.Description = d.Get("description").(string)
+
+	params := dcim.NewDcimInterfaceTemplatesPartialUpdateParams().WithID(id).WithData(&data)
+
+	_, err := api.Dcim.DcimInterfaceTemplatesPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDeviceTypeInterfaceTemplateRead(d, m)
+}
+
+func resourceNetboxDeviceTypeInterfaceTemplateDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimInterfaceTemplatesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimInterfaceTemplatesDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimInterfaceTemplatesDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
+}
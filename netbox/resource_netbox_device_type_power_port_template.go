@@ -0,0 +1,195 @@
+package netbox
+
+import (
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxDeviceTypePowerPortTemplateTypeOptions = []string{"iec-60320-c6", "iec-60320-c8", "iec-60320-c14", "iec-60320-c20", "nema-5-15p", "nema-l5-15p", "nema-5-20p", "other"}
+
+func resourceNetboxDeviceTypePowerPortTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDeviceTypePowerPortTemplateCreate,
+		Read:   resourceNetboxDeviceTypePowerPortTemplateRead,
+		Update: resourceNetboxDeviceTypePowerPortTemplateUpdate,
+		Delete: resourceNetboxDeviceTypePowerPortTemplateDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/features/device-types/#component-templates):
+
+> Component templates effectively serve as a pattern for the automatic creation of components when a new device is added.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_type_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the device type this power port template belongs to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the power port template (e.g. 'PSU{n}').",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxDeviceTypePowerPortTemplateTypeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxDeviceTypePowerPortTemplateTypeOptions),
+			},
+			"maximum_draw": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Maximum power draw, in watts.",
+			},
+			"allocated_draw": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Allocated power draw, in watts.",
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Physical label of the power port template.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Brief description of the power port template.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxDeviceTypePowerPortTemplateCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	data := models.WritablePowerPortTemplate{}
+
+	deviceTypeID := int64(d.Get("device_type_id").(int))
+	data.DeviceType = &deviceTypeID
+
+	name := d.Get("name").(string)
+	data.Name = &name
+
+	if portType, ok := d.GetOk("type"); ok {
+		data.Type = portType.(string)
+	}
+
+	if maximumDraw, ok := d.GetOk("maximum_draw"); ok {
+		data.MaximumDraw = int64ToPtr(int64(maximumDraw.(int)))
+	}
+
+	if allocatedDraw, ok := d.GetOk("allocated_draw"); ok {
+		data.AllocatedDraw = int64ToPtr(int64(allocatedDraw.(int)))
+	}
+
+	data.Label = d.Get("label").(string)
+	data.Description = d.Get("description").(string)
+
+	params := dcim.NewDcimPowerPortTemplatesCreateParams().WithData(&data)
+
+	res, err := api.Dcim.DcimPowerPortTemplatesCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxDeviceTypePowerPortTemplateRead(d, m)
+}
+
+func resourceNetboxDeviceTypePowerPortTemplateRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimPowerPortTemplatesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimPowerPortTemplatesRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimPowerPortTemplatesReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	powerPortTemplate := res.GetPayload()
+	d.Set("device_type_id", powerPortTemplate.DeviceType.ID)
+	d.Set("name", powerPortTemplate.Name)
+	if powerPortTemplate.Type != nil {
+		d.Set("type", powerPortTemplate.Type.Value)
+	}
+	d.Set("maximum_draw", powerPortTemplate.MaximumDraw)
+	d.Set("allocated_draw", powerPortTemplate.AllocatedDraw)
+	d.Set("label", powerPortTemplate.Label)
+	d.Set("description", powerPortTemplate.Description)
+
+	return nil
+}
+
+func resourceNetboxDeviceTypePowerPortTemplateUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	data := models.WritablePowerPortTemplate{}
+
+	deviceTypeID := int64(d.Get("device_type_id").(int))
+	data.DeviceType = &deviceTypeID
+
+	name := d.Get("name").(string)
+	data.Name = &name
+
+	if portType, ok := d.GetOk("type"); ok {
+		data.Type = portType.(string)
+	}
+
+	if maximumDraw, ok := d.GetOk("maximum_draw"); ok {
+		data.MaximumDraw = int64ToPtr(int64(maximumDraw.(int)))
+	}
+
+	if allocatedDraw, ok := d.GetOk("allocated_draw"); ok {
+		data.AllocatedDraw = int64ToPtr(int64(allocatedDraw.(int)))
+	}
+
+	data.Label = d.Get("label").(string)
+	data.Description = d.Get("description").(string)
+
+	params := dcim.NewDcimPowerPortTemplatesPartialUpdateParams().WithID(id).WithData(&data)
+
+	_, err := api.Dcim.DcimPowerPortTemplatesPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDeviceTypePowerPortTemplateRead(d, m)
+}
+
+func resourceNetboxDeviceTypePowerPortTemplateDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimPowerPortTemplatesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimPowerPortTemplatesDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimPowerPortTemplatesDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
+}
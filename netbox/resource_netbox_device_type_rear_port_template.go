@@ -0,0 +1,174 @@
+package netbox
+
+import (
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxDeviceTypeRearPortTemplateTypeOptions = []string{"8p8c", "110-punch", "bnc", "mrj21", "fc", "lc", "sc", "st", "other"}
+
+func resourceNetboxDeviceTypeRearPortTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDeviceTypeRearPortTemplateCreate,
+		Read:   resourceNetboxDeviceTypeRearPortTemplateRead,
+		Update: resourceNetboxDeviceTypeRearPortTemplateUpdate,
+		Delete: resourceNetboxDeviceTypeRearPortTemplateDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/features/device-types/#component-templates):
+
+> Component templates effectively serve as a pattern for the automatic creation of components when a new device is added.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_type_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the device type this rear port template belongs to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the rear port template (e.g. 'Rear{n}').",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxDeviceTypeRearPortTemplateTypeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxDeviceTypeRearPortTemplateTypeOptions),
+			},
+			"positions": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "Number of front ports that can be mapped to this rear port.",
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Physical label of the rear port template.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Brief description of the rear port template.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxDeviceTypeRearPortTemplateCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	data := models.WritableRearPortTemplate{}
+
+	deviceTypeID := int64(d.Get("device_type_id").(int))
+	data.DeviceType = &deviceTypeID
+
+	name := d.Get("name").(string)
+	data.Name = &name
+
+	portType := d.Get("type").(string)
+	data.Type = &portType
+
+	data.Positions = int64(d.Get("positions").(int))
+	data.Label = d.Get("label").(string)
+	data.Description = d.Get("description").(string)
+
+	params := dcim.NewDcimRearPortTemplatesCreateParams().WithData(&data)
+
+	res, err := api.Dcim.DcimRearPortTemplatesCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxDeviceTypeRearPortTemplateRead(d, m)
+}
+
+func resourceNetboxDeviceTypeRearPortTemplateRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimRearPortTemplatesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimRearPortTemplatesRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimRearPortTemplatesReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	rearPortTemplate := res.GetPayload()
+	d.Set("device_type_id", rearPortTemplate.DeviceType.ID)
+	d.Set("name", rearPortTemplate.Name)
+	if rearPortTemplate.Type != nil {
+		d.Set("type", rearPortTemplate.Type.Value)
+	}
+	d.Set("positions", rearPortTemplate.Positions)
+	d.Set("label", rearPortTemplate.Label)
+	d.Set("description", rearPortTemplate.Description)
+
+	return nil
+}
+
+func resourceNetboxDeviceTypeRearPortTemplateUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	data := models.WritableRearPortTemplate{}
+
+	deviceTypeID := int64(d.Get("device_type_id").(int))
+	data.DeviceType = &deviceTypeID
+
+	name := d.Get("name").(string)
+	data.Name = &name
+
+	portType := d.Get("type").(string)
+	data.Type = &portType
+
+	data.Positions = int64(d.Get("positions").(int))
+	data.Label = d.Get("label").(string)
+	data.Description = d.Get("description").(string)
+
+	params := dcim.NewDcimRearPortTemplatesPartialUpdateParams().WithID(id).WithData(&data)
+
+	_, err := api.Dcim.DcimRearPortTemplatesPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDeviceTypeRearPortTemplateRead(d, m)
+}
+
+func resourceNetboxDeviceTypeRearPortTemplateDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimRearPortTemplatesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimRearPortTemplatesDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimRearPortTemplatesDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
+}
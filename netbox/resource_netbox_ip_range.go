@@ -1,6 +1,10 @@
 package netbox
 
 import (
+	"context"
+	"fmt"
+	"math/big"
+	"net"
 	"strconv"
 	"strings"
 
@@ -13,6 +17,158 @@ import (
 
 var resourceNetboxIPRangeStatusOptions = []string{"active", "reserved", "deprecated"}
 
+// ipRangeMaxIPv6Size mirrors the cap NetBox itself applies to the `size`
+// property of an IPRange: a /0 IPv6 range technically contains 2^128
+// addresses, far more than fits in a practical computed attribute, so the
+// reported size is capped at 2^32, the same limit NetBox uses.
+var ipRangeMaxIPv6Size = new(big.Int).Lsh(big.NewInt(1), 32)
+
+// parseIPRangeAddress parses an IP range endpoint, requiring CIDR notation
+// (a mask is mandatory, matching the field's documentation) and returns the
+// parsed IP alongside its network so callers can inspect family and prefix
+// length.
+func parseIPRangeAddress(value string) (net.IP, *net.IPNet, error) {
+	ip, ipNet, err := net.ParseCIDR(value)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%q is not a valid CIDR address: %w", value, err)
+	}
+	return ip, ipNet, nil
+}
+
+func validateIPRangeAddress(i interface{}, k string) ([]string, []error) {
+	value, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	if _, _, err := parseIPRangeAddress(value); err != nil {
+		return nil, []error{fmt.Errorf("%q: %w", k, err)}
+	}
+
+	return nil, nil
+}
+
+// canonicalizeIPRangeAddress reformats a CIDR address into its canonical
+// string form (e.g. `2001:0db8::1/64` and `2001:db8::1/64` both normalize to
+// `2001:db8::1/64`) so cosmetic reformatting returned by the API doesn't
+// produce a perpetual diff.
+func canonicalizeIPRangeAddress(value string) string {
+	ip, ipNet, err := parseIPRangeAddress(value)
+	if err != nil {
+		return value
+	}
+	ones, _ := ipNet.Mask.Size()
+	return fmt.Sprintf("%s/%d", ip.String(), ones)
+}
+
+func diffSuppressIPRangeAddress(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	return canonicalizeIPRangeAddress(oldValue) == canonicalizeIPRangeAddress(newValue)
+}
+
+// ipToBigInt converts an IP address into a big.Int so start/end addresses of
+// either family can be compared numerically.
+func ipToBigInt(ip net.IP) *big.Int {
+	if v4 := ip.To4(); v4 != nil {
+		return new(big.Int).SetBytes(v4)
+	}
+	return new(big.Int).SetBytes(ip.To16())
+}
+
+func resourceNetboxIPRangeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	startValue, ok := d.GetOk("start_address")
+	if !ok {
+		return nil
+	}
+	endValue, ok := d.GetOk("end_address")
+	if !ok {
+		return nil
+	}
+
+	startIP, startNet, err := parseIPRangeAddress(startValue.(string))
+	if err != nil {
+		return err
+	}
+	endIP, endNet, err := parseIPRangeAddress(endValue.(string))
+	if err != nil {
+		return err
+	}
+
+	startIsV4 := startIP.To4() != nil
+	endIsV4 := endIP.To4() != nil
+	if startIsV4 != endIsV4 {
+		return fmt.Errorf("start_address and end_address must be the same IP family, got %q and %q", startValue, endValue)
+	}
+
+	startOnes, startBits := startNet.Mask.Size()
+	endOnes, endBits := endNet.Mask.Size()
+	if startOnes != endOnes || startBits != endBits {
+		return fmt.Errorf("start_address and end_address must share the same prefix length, got /%d and /%d", startOnes, endOnes)
+	}
+
+	startInt := ipToBigInt(startIP)
+	endInt := ipToBigInt(endIP)
+	if startInt.Cmp(endInt) > 0 {
+		return fmt.Errorf("start_address %q must not be greater than end_address %q", startValue, endValue)
+	}
+
+	size := new(big.Int).Sub(endInt, startInt)
+	size.Add(size, big.NewInt(1))
+	if size.Cmp(ipRangeMaxIPv6Size) > 0 {
+		size = ipRangeMaxIPv6Size
+	}
+
+	return d.SetNew("size", int(size.Int64()))
+}
+
+// reserveIPRangeFromPrefix finds count consecutive available addresses under
+// prefixID and returns them as a start/end pair suitable for a WritableIPRange.
+// NetBox has no endpoint that reserves a contiguous range directly, so this
+// previews the prefix's available-ips list (which NetBox returns in ascending
+// order) and requires the first count entries to be truly consecutive; if
+// they aren't, it fails rather than silently returning a range that spans
+// addresses already in use.
+func reserveIPRangeFromPrefix(api *client.NetBoxAPI, prefixID int64, count int) (string, string, error) {
+	params := ipam.NewIpamPrefixesAvailableIpsListParams().WithID(prefixID)
+	res, err := api.Ipam.IpamPrefixesAvailableIpsList(params, nil)
+	if err != nil {
+		return "", "", fmt.Errorf("failed listing available IPs for prefix %d: %w", prefixID, err)
+	}
+
+	available := res.GetPayload()
+	if len(available) < count {
+		return "", "", fmt.Errorf("prefix %d has only %d available address(es), need %d", prefixID, len(available), count)
+	}
+
+	startIP, startNet, err := parseIPRangeAddress(available[0].Address)
+	if err != nil {
+		return "", "", err
+	}
+	startOnes, startBits := startNet.Mask.Size()
+	startInt := ipToBigInt(startIP)
+
+	endAddress := available[0].Address
+	for i := 1; i < count; i++ {
+		ip, ipNet, err := parseIPRangeAddress(available[i].Address)
+		if err != nil {
+			return "", "", err
+		}
+
+		ones, bits := ipNet.Mask.Size()
+		if ones != startOnes || bits != startBits {
+			return "", "", fmt.Errorf("prefix %d returned available addresses with mismatched prefix lengths", prefixID)
+		}
+
+		want := new(big.Int).Add(startInt, big.NewInt(int64(i)))
+		if ipToBigInt(ip).Cmp(want) != 0 {
+			return "", "", fmt.Errorf("prefix %d does not have %d consecutive available addresses starting at %s", prefixID, count, startIP.String())
+		}
+
+		endAddress = available[i].Address
+	}
+
+	return available[0].Address, endAddress, nil
+}
+
 func resourceNetboxIPRange() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNetboxIPRangeCreate,
@@ -26,14 +182,43 @@ func resourceNetboxIPRange() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"start_address": {
-				Type:         schema.TypeString,
-				Required:     true,
-				Description:  "The first address of the IP range. Needs CIDR notation.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateIPRangeAddress,
+				DiffSuppressFunc: diffSuppressIPRangeAddress,
+				ConflictsWith:    []string{"parent_prefix_id"},
+				Description:      "The first address of the IP range. Needs CIDR notation. Required unless parent_prefix_id and address_count are set.",
 			},
 			"end_address": {
-				Type:         schema.TypeString,
-				Required: 	  true,
-				Description:  "The last address of the IP range. Needs CIDR notation.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateIPRangeAddress,
+				DiffSuppressFunc: diffSuppressIPRangeAddress,
+				ConflictsWith:    []string{"parent_prefix_id"},
+				Description:      "The last address of the IP range. Needs CIDR notation. Required unless parent_prefix_id and address_count are set.",
+			},
+			"parent_prefix_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				RequiredWith:  []string{"address_count"},
+				ConflictsWith: []string{"start_address", "end_address"},
+				Description:   "ID of a prefix to reserve the next address_count consecutive available addresses from, instead of specifying start_address/end_address directly.",
+			},
+			"address_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"parent_prefix_id"},
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Number of consecutive available addresses to reserve from parent_prefix_id.",
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of addresses in the range, inclusive of start_address and end_address. Capped at 2^32, matching NetBox's own limit for IPv6 ranges.",
 			},
 			"status": {
 				Type:         schema.TypeString,
@@ -79,6 +264,7 @@ func resourceNetboxIPRange() *schema.Resource {
 			},
 			tagsKey: tagsSchema,
 		},
+		CustomizeDiff: resourceNetboxIPRangeCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -89,13 +275,38 @@ func resourceNetboxIPRangeCreate(d *schema.ResourceData, m interface{}) error {
 	api := m.(*client.NetBoxAPI)
 	data := models.WritableIPRange{}
 
-	data.StartAddress = strToPtr(d.Get("start_address").(string))
-	data.EndAddress = strToPtr(d.Get("end_address").(string))
+	startAddress := d.Get("start_address").(string)
+	endAddress := d.Get("end_address").(string)
+
+	if parentPrefixIDValue, ok := d.GetOk("parent_prefix_id"); ok {
+		count := d.Get("address_count").(int)
+
+		lock := availableIPAddressParentLock(fmt.Sprintf("prefix/%d", parentPrefixIDValue.(int)))
+		lock.Lock()
+		reservedStart, reservedEnd, err := reserveIPRangeFromPrefix(api, int64(parentPrefixIDValue.(int)), count)
+		lock.Unlock()
+		if err != nil {
+			return err
+		}
+
+		startAddress = reservedStart
+		endAddress = reservedEnd
+		d.Set("start_address", startAddress)
+		d.Set("end_address", endAddress)
+	}
+
+	data.StartAddress = strToPtr(startAddress)
+	data.EndAddress = strToPtr(endAddress)
 
 	data.Status = d.Get("status").(string)
 	data.Description = getOptionalStr(d, "description", true)
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	managedTags, err := applyManagedByTag(api, data.Tags)
+	if err != nil {
+		return err
+	}
+	data.Tags = managedTags
 
 	params := ipam.NewIpamIPRangesCreateParams().WithData(&data)
 	res, err := api.Ipam.IpamIPRangesCreate(params, nil)
@@ -137,6 +348,10 @@ func resourceNetboxIPRangeRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("status", res.GetPayload().Status.Value)
 	}
 
+	if res.GetPayload().Size != 0 {
+		d.Set("size", res.GetPayload().Size)
+	}
+
 	if res.GetPayload().Vrf != nil {
 		d.Set("vrf_id", res.GetPayload().Vrf.ID)
 	}
@@ -153,7 +368,7 @@ func resourceNetboxIPRangeRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("role_id", res.GetPayload().Role.ID)
 	}
 
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getTagListFromNestedTagList(stripManagedByTag(api, res.GetPayload().Tags)))
 
 	return nil
 }
@@ -184,9 +399,14 @@ func resourceNetboxIPRangeUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	managedTags, err := applyManagedByTag(api, data.Tags)
+	if err != nil {
+		return err
+	}
+	data.Tags = managedTags
 
 	params := ipam.NewIpamIPRangesUpdateParams().WithID(id).WithData(&data)
-	_, err := api.Ipam.IpamIPRangesUpdate(params, nil)
+	_, err = api.Ipam.IpamIPRangesUpdate(params, nil)
 	if err != nil {
 		return err
 	}
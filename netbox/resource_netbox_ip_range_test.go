@@ -0,0 +1,45 @@
+package netbox
+
+import "testing"
+
+func TestCanonicalizeIPRangeAddress(t *testing.T) {
+	cases := []struct {
+		in   string
+		want string
+	}{
+		{"192.0.2.1/24", "192.0.2.1/24"},
+		{"2001:0db8::1/64", "2001:db8::1/64"},
+		{"2001:db8::1/64", "2001:db8::1/64"},
+		{"not-an-address", "not-an-address"},
+	}
+
+	for _, c := range cases {
+		if got := canonicalizeIPRangeAddress(c.in); got != c.want {
+			t.Errorf("canonicalizeIPRangeAddress(%q) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
+
+func TestDiffSuppressIPRangeAddress(t *testing.T) {
+	if !diffSuppressIPRangeAddress("start_address", "2001:0db8::1/64", "2001:db8::1/64", nil) {
+		t.Errorf("expected cosmetically different but equivalent addresses to suppress the diff")
+	}
+	if diffSuppressIPRangeAddress("start_address", "192.0.2.1/24", "192.0.2.2/24", nil) {
+		t.Errorf("expected genuinely different addresses not to suppress the diff")
+	}
+}
+
+func TestValidateIPRangeAddress(t *testing.T) {
+	if _, errs := validateIPRangeAddress("192.0.2.1/24", "start_address"); len(errs) != 0 {
+		t.Errorf("expected valid CIDR address not to error, got %v", errs)
+	}
+	if _, errs := validateIPRangeAddress("192.0.2.1", "start_address"); len(errs) == 0 {
+		t.Errorf("expected address without a mask to error")
+	}
+	if _, errs := validateIPRangeAddress("not-an-address", "start_address"); len(errs) == 0 {
+		t.Errorf("expected garbage input to error")
+	}
+	if _, errs := validateIPRangeAddress(123, "start_address"); len(errs) == 0 {
+		t.Errorf("expected non-string input to error")
+	}
+}
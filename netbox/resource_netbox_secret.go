@@ -0,0 +1,206 @@
+package netbox
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"strconv"
+	"strings"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+type secret struct {
+	ID                 int64  `json:"id,omitempty"`
+	Role               int64  `json:"role"`
+	AssignedObjectType string `json:"assigned_object_type"`
+	AssignedObjectID   int64  `json:"assigned_object_id"`
+	Name               string `json:"name,omitempty"`
+	Plaintext          string `json:"plaintext,omitempty"`
+	Hash               string `json:"hash,omitempty"`
+}
+
+// secretUpdate is the PATCH payload for resourceNetboxSecretUpdate. It
+// deliberately excludes assigned_object_type/assigned_object_id: both are
+// ForceNew and immutable after creation, so sending them at all - even as
+// zero values - risks the Secrets plugin rejecting the request or re-pointing
+// the secret at a different object.
+type secretUpdate struct {
+	Role      int64  `json:"role,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Plaintext string `json:"plaintext,omitempty"`
+}
+
+func resourceNetboxSecret() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxSecretCreate,
+		Read:   resourceNetboxSecretRead,
+		Update: resourceNetboxSecretUpdate,
+		Delete: resourceNetboxSecretDelete,
+
+		Description: `:meta:subcategory:Secrets:From the [NetBox Secrets plugin documentation](https://github.com/netbox-community/netbox-secrets):
+
+> A secret is used to store confidential information relevant to NetBox objects, such as device access credentials. Secrets are stored in the database as ciphertext, encrypted using a combination of the NetBox secret key and the user's private key, and are only ever decrypted to plaintext on demand by a user holding that private key.
+
+Requires the provider to be configured with ` + "`private_key`" + ` or ` + "`private_key_file`" + `.`,
+
+		Schema: map[string]*schema.Schema{
+			"role_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the role this secret is classified under.",
+			},
+			"assigned_object_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Content type of the object this secret is attached to (e.g. 'dcim.device').",
+			},
+			"assigned_object_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the object this secret is attached to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the secret.",
+			},
+			"plaintext": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Decrypted value of the secret. Drift is detected by comparing a hash of this value against the hash NetBox reports, so the plaintext itself never has to round-trip on an unchanged read.",
+			},
+		},
+	}
+}
+
+// secretHashMatches reports whether plaintext hashes to the Django PBKDF2
+// digest the secrets plugin reports in a secret's `hash` field, formatted as
+// `pbkdf2_sha256$<iterations>$<salt>$<digest>`. This lets Read confirm a
+// secret's plaintext is unchanged using only the (undecrypted) hash, so the
+// `?decrypt=true` round-trip is only needed when it actually has changed.
+func secretHashMatches(hash, plaintext string) bool {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 4 || parts[0] != "pbkdf2_sha256" {
+		return false
+	}
+
+	iterations, err := strconv.Atoi(parts[1])
+	if err != nil || iterations <= 0 {
+		return false
+	}
+	salt := parts[2]
+
+	expected, err := base64.StdEncoding.DecodeString(parts[3])
+	if err != nil {
+		return false
+	}
+
+	derived := pbkdf2.Key([]byte(plaintext), []byte(salt), iterations, len(expected), sha256.New)
+
+	return subtle.ConstantTimeCompare(derived, expected) == 1
+}
+
+func resourceNetboxSecretCreate(d *schema.ResourceData, m interface{}) error {
+	secretsAPI, err := getSecretsClient(m.(*client.NetBoxAPI))
+	if err != nil {
+		return err
+	}
+
+	s := secret{
+		Role:               int64(d.Get("role_id").(int)),
+		AssignedObjectType: d.Get("assigned_object_type").(string),
+		AssignedObjectID:   int64(d.Get("assigned_object_id").(int)),
+		Name:               d.Get("name").(string),
+		Plaintext:          d.Get("plaintext").(string),
+	}
+
+	var created secret
+	if err := secretsAPI.do("POST", "/api/plugins/secrets/secrets/", &s, &created); err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(created.ID, 10))
+
+	return resourceNetboxSecretRead(d, m)
+}
+
+func resourceNetboxSecretRead(d *schema.ResourceData, m interface{}) error {
+	secretsAPI, err := getSecretsClient(m.(*client.NetBoxAPI))
+	if err != nil {
+		return err
+	}
+
+	var s secret
+	err = secretsAPI.do("GET", "/api/plugins/secrets/secrets/"+d.Id()+"/", nil, &s)
+	if err != nil {
+		if isSecretsNotFoundError(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("role_id", s.Role)
+	d.Set("assigned_object_type", s.AssignedObjectType)
+	d.Set("assigned_object_id", s.AssignedObjectID)
+	d.Set("name", s.Name)
+
+	// The undecrypted read above already reports the secret's PBKDF2 hash, so
+	// only fall back to a ?decrypt=true round-trip when the configured
+	// plaintext no longer matches it.
+	if secretHashMatches(s.Hash, d.Get("plaintext").(string)) {
+		return nil
+	}
+
+	var decrypted secret
+	if err := secretsAPI.do("GET", "/api/plugins/secrets/secrets/"+d.Id()+"/?decrypt=true", nil, &decrypted); err != nil {
+		return err
+	}
+	d.Set("plaintext", decrypted.Plaintext)
+
+	return nil
+}
+
+func resourceNetboxSecretUpdate(d *schema.ResourceData, m interface{}) error {
+	secretsAPI, err := getSecretsClient(m.(*client.NetBoxAPI))
+	if err != nil {
+		return err
+	}
+
+	s := secretUpdate{
+		Role:      int64(d.Get("role_id").(int)),
+		Name:      d.Get("name").(string),
+		Plaintext: d.Get("plaintext").(string),
+	}
+
+	if err := secretsAPI.do("PATCH", "/api/plugins/secrets/secrets/"+d.Id()+"/", &s, nil); err != nil {
+		return err
+	}
+
+	return resourceNetboxSecretRead(d, m)
+}
+
+func resourceNetboxSecretDelete(d *schema.ResourceData, m interface{}) error {
+	secretsAPI, err := getSecretsClient(m.(*client.NetBoxAPI))
+	if err != nil {
+		return err
+	}
+
+	err = secretsAPI.do("DELETE", "/api/plugins/secrets/secrets/"+d.Id()+"/", nil, nil)
+	if err != nil {
+		if isSecretsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package netbox
+
+import (
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type secretRole struct {
+	ID          int64  `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	Description string `json:"description,omitempty"`
+}
+
+func resourceNetboxSecretRole() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxSecretRoleCreate,
+		Read:   resourceNetboxSecretRoleRead,
+		Update: resourceNetboxSecretRoleUpdate,
+		Delete: resourceNetboxSecretRoleDelete,
+
+		Description: `:meta:subcategory:Secrets:From the [NetBox Secrets plugin documentation](https://github.com/netbox-community/netbox-secrets):
+
+> Secret roles are used to classify secrets by function. For example, you might create roles for login credentials, SNMP communities, and IKE key exchanges. Each secret must be assigned a role.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the secret role.",
+			},
+			"slug": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Unique slug used in URLs for the secret role. If not provided, it will be generated from the name.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Brief description of the secret role.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxSecretRoleCreate(d *schema.ResourceData, m interface{}) error {
+	secretsAPI, err := getSecretsClient(m.(*client.NetBoxAPI))
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+	slug := d.Get("slug").(string)
+	if slug == "" {
+		slug = getSlug(name)
+	}
+
+	role := secretRole{
+		Name:        name,
+		Slug:        slug,
+		Description: d.Get("description").(string),
+	}
+
+	var created secretRole
+	if err := secretsAPI.do("POST", "/api/plugins/secrets/secret-roles/", &role, &created); err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(created.ID, 10))
+
+	return resourceNetboxSecretRoleRead(d, m)
+}
+
+func resourceNetboxSecretRoleRead(d *schema.ResourceData, m interface{}) error {
+	secretsAPI, err := getSecretsClient(m.(*client.NetBoxAPI))
+	if err != nil {
+		return err
+	}
+
+	var role secretRole
+	err = secretsAPI.do("GET", "/api/plugins/secrets/secret-roles/"+d.Id()+"/", nil, &role)
+	if err != nil {
+		if isSecretsNotFoundError(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", role.Name)
+	d.Set("slug", role.Slug)
+	d.Set("description", role.Description)
+
+	return nil
+}
+
+func resourceNetboxSecretRoleUpdate(d *schema.ResourceData, m interface{}) error {
+	secretsAPI, err := getSecretsClient(m.(*client.NetBoxAPI))
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+	slug := d.Get("slug").(string)
+	if slug == "" {
+		slug = getSlug(name)
+	}
+
+	role := secretRole{
+		Name:        name,
+		Slug:        slug,
+		Description: d.Get("description").(string),
+	}
+
+	if err := secretsAPI.do("PATCH", "/api/plugins/secrets/secret-roles/"+d.Id()+"/", &role, nil); err != nil {
+		return err
+	}
+
+	return resourceNetboxSecretRoleRead(d, m)
+}
+
+func resourceNetboxSecretRoleDelete(d *schema.ResourceData, m interface{}) error {
+	secretsAPI, err := getSecretsClient(m.(*client.NetBoxAPI))
+	if err != nil {
+		return err
+	}
+
+	err = secretsAPI.do("DELETE", "/api/plugins/secrets/secret-roles/"+d.Id()+"/", nil, nil)
+	if err != nil {
+		if isSecretsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
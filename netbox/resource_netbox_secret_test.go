@@ -0,0 +1,74 @@
+package netbox
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+func buildTestPBKDF2Hash(plaintext, salt string, iterations int) string {
+	derived := pbkdf2.Key([]byte(plaintext), []byte(salt), iterations, sha256.Size, sha256.New)
+	return "pbkdf2_sha256$" + strconv.Itoa(iterations) + "$" + salt + "$" + base64.StdEncoding.EncodeToString(derived)
+}
+
+func TestSecretHashMatches(t *testing.T) {
+	hash := buildTestPBKDF2Hash("hunter2", "somesalt", 100000)
+
+	if !secretHashMatches(hash, "hunter2") {
+		t.Errorf("expected hash to match its own plaintext")
+	}
+	if secretHashMatches(hash, "wrong") {
+		t.Errorf("expected hash not to match a different plaintext")
+	}
+}
+
+func TestSecretHashMatchesMalformedHash(t *testing.T) {
+	cases := []string{
+		"",
+		"not-a-pbkdf2-hash",
+		"pbkdf2_sha256$abc$salt$digest",
+		"sha256$100000$salt$digest",
+		"pbkdf2_sha256$100000$salt$not-base64!",
+	}
+	for _, c := range cases {
+		if secretHashMatches(c, "hunter2") {
+			t.Errorf("expected malformed hash %q not to match", c)
+		}
+	}
+}
+
+// TestSecretUpdatePayloadOmitsImmutableFields guards against regressing into
+// sending assigned_object_type/assigned_object_id on an update that only
+// changes name/plaintext/role: the Secrets plugin treats both as immutable,
+// so resending them (even as zero values) must never happen.
+func TestSecretUpdatePayloadOmitsImmutableFields(t *testing.T) {
+	s := secretUpdate{
+		Role:      4,
+		Name:      "updated-name",
+		Plaintext: "new-plaintext",
+	}
+
+	encoded, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, field := range []string{"assigned_object_type", "assigned_object_id"} {
+		if _, ok := decoded[field]; ok {
+			t.Errorf("expected update payload not to include immutable field %q, got %s", field, encoded)
+		}
+	}
+
+	if decoded["name"] != "updated-name" || decoded["plaintext"] != "new-plaintext" || decoded["role"] != float64(4) {
+		t.Errorf("expected update payload to include the changed role/name/plaintext, got %s", encoded)
+	}
+}
@@ -0,0 +1,187 @@
+package netbox
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+)
+
+// secretsSessionKeyHeader is the header NetBox's secrets plugin expects on every
+// request that needs to decrypt a secret's plaintext.
+const secretsSessionKeyHeader = "X-Session-Key"
+
+// secretsClient talks directly to the NetBox Secrets plugin's REST endpoints.
+// The plugin isn't part of go-netbox's generated client, so requests are built
+// and decoded by hand, the same way the rest of this provider treats go-netbox
+// as the source of truth for core NetBox endpoints only.
+type secretsClient struct {
+	baseURL    string
+	token      string
+	sessionKey string
+	httpClient *http.Client
+}
+
+func newSecretsClient(baseURL, token, sessionKey string, insecure bool) *secretsClient {
+	transport := &http.Transport{}
+	if insecure {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec
+	}
+	return &secretsClient{
+		baseURL:    baseURL,
+		token:      token,
+		sessionKey: sessionKey,
+		httpClient: &http.Client{Transport: transport},
+	}
+}
+
+// secretsClientByClient holds the secretsClient built by providerConfigure for
+// each *client.NetBoxAPI instance once a session key has been negotiated from
+// private_key/private_key_file. The secrets plugin sits outside go-netbox's
+// generated client, so its resources reach it through this accessor instead of
+// the *client.NetBoxAPI passed as meta everywhere else; keying by that same api
+// instance keeps multiple provider configurations/aliases in the same process
+// from clobbering each other's session key.
+var secretsClientByClient sync.Map
+
+// configureSecretsClient should be called from providerConfigure once the
+// NetBox host, API token and (if configured) private key are known. It
+// exchanges the private key for a session key and stores the resulting
+// client for netbox_secret and netbox_secret_role to use with this api
+// instance.
+func configureSecretsClient(api *client.NetBoxAPI, baseURL, token, privateKey string, insecure bool) error {
+	if privateKey == "" {
+		secretsClientByClient.Delete(api)
+		return nil
+	}
+
+	httpClient := &http.Client{}
+	if insecure {
+		httpClient.Transport = &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}} //nolint:gosec
+	}
+
+	sessionKey, err := fetchSecretsSessionKey(httpClient, baseURL, token, privateKey)
+	if err != nil {
+		return err
+	}
+
+	secretsClientByClient.Store(api, newSecretsClient(baseURL, token, sessionKey, insecure))
+	return nil
+}
+
+func getSecretsClient(api *client.NetBoxAPI) (*secretsClient, error) {
+	value, ok := secretsClientByClient.Load(api)
+	if !ok {
+		return nil, fmt.Errorf("the NetBox Secrets plugin is not configured: set private_key or private_key_file on the provider")
+	}
+	return value.(*secretsClient), nil
+}
+
+// fetchSecretsSessionKey exchanges the user's RSA private key for a session key
+// by POSTing to /api/plugins/secrets/get-session-key/. The returned key must be
+// sent as the X-Session-Key header on every subsequent request that reads or
+// writes secret plaintext.
+func fetchSecretsSessionKey(httpClient *http.Client, baseURL, token, privateKey string) (string, error) {
+	payload, err := json.Marshal(map[string]string{"private_key": privateKey})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, baseURL+"/api/plugins/secrets/get-session-key/", bytes.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Token "+token)
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed requesting secrets session key: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed requesting secrets session key: %s: %s", res.Status, string(body))
+	}
+
+	var parsed struct {
+		SessionKey string `json:"session_key"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return "", fmt.Errorf("failed parsing secrets session key response: %w", err)
+	}
+	if parsed.SessionKey == "" {
+		return "", fmt.Errorf("secrets session key response did not contain a session_key")
+	}
+
+	return parsed.SessionKey, nil
+}
+
+func (c *secretsClient) do(method, path string, body interface{}, out interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Token "+c.token)
+	if c.sessionKey != "" {
+		req.Header.Set(secretsSessionKeyHeader, c.sessionKey)
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	respBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return &secretsNotFoundError{path: path}
+	}
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("secrets plugin request to %s failed: %s: %s", path, res.Status, string(respBody))
+	}
+
+	if out == nil || len(respBody) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(respBody, out)
+}
+
+type secretsNotFoundError struct {
+	path string
+}
+
+func (e *secretsNotFoundError) Error() string {
+	return fmt.Sprintf("secrets plugin resource not found: %s", e.path)
+}
+
+func isSecretsNotFoundError(err error) bool {
+	_, ok := err.(*secretsNotFoundError)
+	return ok
+}